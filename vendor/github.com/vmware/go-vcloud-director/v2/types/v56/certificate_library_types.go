@@ -0,0 +1,35 @@
+/*
+ * Copyright 2021 VMware, Inc.  All rights reserved.  Licensed under the Apache v2 License.
+ */
+
+package types
+
+// CertificateLibraryItem represents a single certificate stored in the org- or system-scoped
+// certificate library exposed by the vCD OpenAPI certificate library endpoints. It is used to
+// back TLS termination on NSX-V edge gateway load balancer virtual servers (see
+// LbAppProfile.ClientSsl/ServerSsl) without requiring certs to be hand-crafted as XML.
+type CertificateLibraryItem struct {
+	Id          string `json:"id,omitempty"`
+	Alias       string `json:"alias"`
+	Description string `json:"description,omitempty"`
+
+	// Certificate is the PEM encoded certificate
+	Certificate string `json:"certificate"`
+	// PrivateKey is the PEM encoded private key. Omitted when the certificate is CA-only
+	PrivateKey string `json:"privateKey,omitempty"`
+	// Passphrase protects PrivateKey, when set
+	Passphrase string `json:"passphrase,omitempty"`
+
+	Issuer          string `json:"issuer,omitempty"`
+	Subject         string `json:"subject,omitempty"`
+	NotBefore       string `json:"notBefore,omitempty"`
+	NotAfter        string `json:"notAfter,omitempty"`
+	SerialNumber    string `json:"serialNumber,omitempty"`
+	Sha1Fingerprint string `json:"sha1Fingerprint,omitempty"`
+}
+
+// CertificateLibraryList is a paginated listing of CertificateLibraryItem as returned by the
+// org- and system-scoped certificate library endpoints
+type CertificateLibraryList struct {
+	Values []CertificateLibraryItem `json:"values"`
+}
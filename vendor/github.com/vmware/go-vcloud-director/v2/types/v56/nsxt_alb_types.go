@@ -0,0 +1,150 @@
+/*
+ * Copyright 2021 VMware, Inc.  All rights reserved.  Licensed under the Apache v2 License.
+ */
+
+package types
+
+// This file models the NSX Advanced Load Balancer (Avi) subsystem exposed by vCD's
+// edgeGatewayLoadBalancerVirtualServices/edgeGatewayLoadBalancerPools OpenAPI endpoints. It is a
+// distinct subsystem from the legacy NSX-V edge load balancer modeled in nsxv_types.go
+// (LbVirtualServer, LbPool, LbMonitor) and lets an edge gateway resource offer an
+// `lb_engine = "avi" | "nsxv"` switch.
+
+// OpenApiReference is a generic ID/Name pair used by the vCD OpenAPI endpoints to reference
+// another entity (gateway, pool, certificate, etc.) without embedding its full representation
+type OpenApiReference struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// AlbController represents a registered NSX Advanced Load Balancer Controller instance
+type AlbController struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Url         string `json:"url"`
+	Username    string `json:"username"`
+	Password    string `json:"password,omitempty"`
+	LicenseType string `json:"licenseType,omitempty"`
+	Version     string `json:"version,omitempty"`
+}
+
+// AlbCloud represents an NSX-T Cloud configured on an AlbController and made available for
+// consumption by an edge gateway
+type AlbCloud struct {
+	ID                       string            `json:"id,omitempty"`
+	Name                     string            `json:"name"`
+	Description              string            `json:"description,omitempty"`
+	LoadBalancerCloudBacking *OpenApiReference `json:"loadBalancerCloudBacking,omitempty"`
+	NetworkPoolRef           *OpenApiReference `json:"networkPoolRef,omitempty"`
+}
+
+// AlbServiceEngineGroup represents a Service Engine Group defined on an AlbCloud and assigned to
+// edge gateways to provide virtual service capacity
+type AlbServiceEngineGroup struct {
+	ID                 string           `json:"id,omitempty"`
+	Name               string           `json:"name"`
+	Description        string           `json:"description,omitempty"`
+	AlbCloudRef        OpenApiReference `json:"loadBalancerCloudRef"`
+	ReservationType    string           `json:"reservationType,omitempty"`
+	MaxVirtualServices *int             `json:"maxVirtualServices,omitempty"`
+	MinVirtualServices *int             `json:"minVirtualServices,omitempty"`
+}
+
+// AlbVirtualService represents an Avi virtual service exposed on an edge gateway
+type AlbVirtualService struct {
+	ID                    string                   `json:"id,omitempty"`
+	Name                  string                   `json:"name"`
+	Description           string                   `json:"description,omitempty"`
+	Enabled               *bool                    `json:"enabled,omitempty"`
+	GatewayRef            OpenApiReference         `json:"gatewayRef"`
+	ServiceEngineGroupRef OpenApiReference         `json:"serviceEngineGroupRef"`
+	VirtualIpAddress      string                   `json:"virtualIpAddress"`
+	ServicePorts          []AlbVirtualServicePort  `json:"servicePorts"`
+	ApplicationProfile    AlbApplicationProfile    `json:"applicationProfile"`
+	PoolRef               OpenApiReference         `json:"poolRef"`
+	CertificateRef        *OpenApiReference        `json:"certificateRef,omitempty"`
+	Status                *AlbVirtualServiceStatus `json:"status,omitempty"`
+}
+
+// AlbVirtualServicePort defines a single port or port range a AlbVirtualService listens on
+type AlbVirtualServicePort struct {
+	PortStart  *int  `json:"portStart"`
+	PortEnd    *int  `json:"portEnd,omitempty"`
+	SslEnabled *bool `json:"sslEnabled,omitempty"`
+}
+
+// AlbApplicationProfile identifies the L4/L7 application profile used by an AlbVirtualService
+type AlbApplicationProfile struct {
+	Type string `json:"type"`
+}
+
+// AlbVirtualServiceStatus reports the service engine assignment and health of an
+// AlbVirtualService as computed by the controller
+type AlbVirtualServiceStatus struct {
+	HealthMessage string `json:"healthMessage,omitempty"`
+	HealthStatus  string `json:"healthStatus,omitempty"`
+	// ServiceEngineGroupRef identifies the AlbServiceEngineGroup the controller actually
+	// assigned to serve this virtual service, which may differ from the group requested on
+	// AlbVirtualService.ServiceEngineGroupRef if the controller rebalanced it
+	ServiceEngineGroupRef *OpenApiReference `json:"serviceEngineGroupRef,omitempty"`
+}
+
+// AlbPool represents a server pool backing one or more AlbVirtualService
+type AlbPool struct {
+	ID          string           `json:"id,omitempty"`
+	Name        string           `json:"name"`
+	Description string           `json:"description,omitempty"`
+	GatewayRef  OpenApiReference `json:"gatewayRef"`
+
+	// Algorithm is one of LEAST_CONNECTIONS, ROUND_ROBIN, CONSISTENT_HASH, FASTEST_RESPONSE,
+	// LEAST_LOAD, FEWEST_SERVERS, RANDOM, FEWEST_TASKS, CORE_AFFINITY
+	Algorithm string `json:"algorithm,omitempty"`
+
+	PassiveMonitoringEnabled *bool                  `json:"passiveMonitoringEnabled,omitempty"`
+	HealthMonitors           []AlbHealthMonitor     `json:"healthMonitors,omitempty"`
+	PersistenceProfile       *AlbPersistenceProfile `json:"persistenceProfile,omitempty"`
+	SslEnabled               *bool                  `json:"sslEnabled,omitempty"`
+	DefaultServerPort        *int                   `json:"defaultServerPort,omitempty"`
+
+	Members        []AlbPoolMember   `json:"members,omitempty"`
+	MemberGroupRef *OpenApiReference `json:"memberGroupRef,omitempty"`
+}
+
+// AlbPoolMember represents a single server behind an AlbPool
+type AlbPoolMember struct {
+	IpAddress string `json:"ipAddress"`
+	Port      int    `json:"port,omitempty"`
+	Ratio     *int   `json:"ratio,omitempty"`
+	Enabled   bool   `json:"enabled"`
+}
+
+// AlbPersistenceProfile configures client persistence for an AlbPool
+type AlbPersistenceProfile struct {
+	Type  string `json:"type,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// AlbHealthMonitor defines a health check performed against AlbPool members
+type AlbHealthMonitor struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+	// Type is one of HTTP, HTTPS, TCP, UDP, PING, DNS, EXTERNAL
+	Type string `json:"type"`
+
+	SendData    string `json:"sendData,omitempty"`
+	ReceiveData string `json:"receiveData,omitempty"`
+
+	SuccessfulChecks int  `json:"successfulChecks,omitempty"`
+	FailedChecks     int  `json:"failedChecks,omitempty"`
+	MonitorPort      *int `json:"monitorPort,omitempty"`
+
+	HttpMonitor *AlbHttpMonitor `json:"httpMonitor,omitempty"`
+}
+
+// AlbHttpMonitor carries the HTTP(S)-specific settings of an AlbHealthMonitor
+type AlbHttpMonitor struct {
+	HttpRequest      string `json:"httpRequest,omitempty"`
+	HttpResponseCode []int  `json:"httpResponseCode,omitempty"`
+	HttpResponse     string `json:"httpResponse,omitempty"`
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright 2021 VMware, Inc.  All rights reserved.  Licensed under the Apache v2 License.
+ */
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// NsxtGatewayPolicy represents a single rule collection (category) of the NSX-T Policy based
+// gateway firewall for a Tier-0/Tier-1 gateway exposed through vCD's NSX-T Policy proxy.
+// Reference: VMware Cloud Director OpenAPI
+// https://code.vmware.com/apis/1260/vmware-cloud-director
+type NsxtGatewayPolicy struct {
+	ID string `json:"id,omitempty"`
+	// Category groups policies into the ordering buckets used by NSX-T - one of
+	// "Emergency", "Infrastructure", "Environment", "Application"
+	Category string `json:"category"`
+	// SequenceNumber defines where this policy is placed relative to other policies in the
+	// same category
+	SequenceNumber int      `json:"sequenceNumber,omitempty"`
+	Stateful       bool     `json:"stateful"`
+	TcpStrict      bool     `json:"tcpStrict"`
+	Locked         bool     `json:"locked"`
+	Comments       string   `json:"comments,omitempty"`
+	Tags           []string `json:"tags,omitempty"`
+
+	Rules []NsxtGatewayFirewallRule `json:"rules,omitempty"`
+}
+
+// NsxtGatewayFirewallRule represents a single rule inside NsxtGatewayPolicy. Source and
+// destination are expressed as NSX-T Policy paths (e.g.
+// "/infra/domains/default/groups/group-id") rather than raw IP addresses so that rules can
+// reference NsxtPolicyGroup objects managed independently.
+type NsxtGatewayFirewallRule struct {
+	ID          string `json:"id,omitempty"`
+	DisplayName string `json:"displayName"`
+
+	SourceGroups      []string `json:"sourceGroups,omitempty"`
+	DestinationGroups []string `json:"destinationGroups,omitempty"`
+	Services          []string `json:"services,omitempty"`
+	Profiles          []string `json:"profiles,omitempty"`
+	Scope             []string `json:"scope,omitempty"`
+
+	// Action is one of ALLOW, DROP, REJECT, JUMP_TO_APPLICATION
+	Action string `json:"action"`
+	// Direction is one of IN, OUT, IN_OUT
+	Direction string `json:"direction"`
+	// IpProtocol is one of IPV4, IPV6, IPV4_IPV6
+	IpProtocol string `json:"ipProtocol"`
+
+	Logged bool   `json:"logged"`
+	Tag    string `json:"tag,omitempty"`
+	Notes  string `json:"notes,omitempty"`
+
+	SequenceNumber int  `json:"sequenceNumber,omitempty"`
+	Disabled       bool `json:"disabled"`
+
+	// AboveRuleId and BelowRuleId are position anchors used only when writing an ordered list
+	// of rules back to vCD - they request that this rule be placed directly above/below the
+	// referenced rule rather than at the position implied by SequenceNumber
+	AboveRuleId string `json:"aboveRuleId,omitempty"`
+	BelowRuleId string `json:"belowRuleId,omitempty"`
+
+	// RawInner preserves any fields returned by the API that are not modeled above so that a
+	// full-replace PUT does not silently drop them, analogous to InnerXML for the NSX-V proxied
+	// API. It is populated by UnmarshalJSON and merged back in by MarshalJSON - fields modeled
+	// on the struct always win over whatever was captured in RawInner, even when left empty.
+	RawInner json.RawMessage `json:"-"`
+}
+
+// nsxtGatewayFirewallRuleAlias has the same fields as NsxtGatewayFirewallRule but none of its
+// methods, so it can be passed to json.Marshal/json.Unmarshal without recursing back into
+// NsxtGatewayFirewallRule's own MarshalJSON/UnmarshalJSON
+type nsxtGatewayFirewallRuleAlias NsxtGatewayFirewallRule
+
+// UnmarshalJSON captures the full API response into RawInner in addition to populating the
+// modeled fields, so that fields not yet modeled above are not lost when the rule is later
+// marshaled back for a full-replace PUT.
+func (r *NsxtGatewayFirewallRule) UnmarshalJSON(data []byte) error {
+	var alias nsxtGatewayFirewallRuleAlias
+	if err := json.Unmarshal(data, &alias); err != nil {
+		return err
+	}
+
+	*r = NsxtGatewayFirewallRule(alias)
+	r.RawInner = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON merges the modeled fields on top of whatever was captured in RawInner, so that a
+// full-replace PUT built from a rule that was read and only partially modified still carries
+// forward fields this type does not yet model.
+func (r NsxtGatewayFirewallRule) MarshalJSON() ([]byte, error) {
+	alias := nsxtGatewayFirewallRuleAlias(r)
+	known, err := json.Marshal(alias)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling known NsxtGatewayFirewallRule fields: %s", err)
+	}
+
+	if len(r.RawInner) == 0 {
+		return known, nil
+	}
+
+	merged := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(r.RawInner, &merged); err != nil {
+		return nil, fmt.Errorf("error unmarshaling RawInner: %s", err)
+	}
+	aliasType := reflect.TypeOf(alias)
+	for i := 0; i < aliasType.NumField(); i++ {
+		name := strings.Split(aliasType.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			delete(merged, name)
+		}
+	}
+	var knownFields map[string]json.RawMessage
+	if err := json.Unmarshal(known, &knownFields); err != nil {
+		return nil, fmt.Errorf("error unmarshaling known NsxtGatewayFirewallRule fields: %s", err)
+	}
+	for key, value := range knownFields {
+		merged[key] = value
+	}
+
+	return json.Marshal(merged)
+}
+
+// NsxtPolicyGroup represents an NSX-T Policy group (e.g. /infra/domains/default/groups/*) that
+// can be referenced from NsxtGatewayFirewallRule.SourceGroups/DestinationGroups
+type NsxtPolicyGroup struct {
+	ID          string `json:"id,omitempty"`
+	Path        string `json:"path,omitempty"`
+	DisplayName string `json:"displayName"`
+	Description string `json:"description,omitempty"`
+
+	Expression json.RawMessage `json:"expression,omitempty"`
+}
+
+// NsxtPolicyService represents an NSX-T Policy service definition that can be referenced from
+// NsxtGatewayFirewallRule.Services
+type NsxtPolicyService struct {
+	ID             string                   `json:"id,omitempty"`
+	Path           string                   `json:"path,omitempty"`
+	DisplayName    string                   `json:"displayName"`
+	Description    string                   `json:"description,omitempty"`
+	ServiceEntries []NsxtPolicyServiceEntry `json:"serviceEntries,omitempty"`
+}
+
+// NsxtPolicyServiceEntry represents a single protocol/port entry inside NsxtPolicyService
+type NsxtPolicyServiceEntry struct {
+	ID               string   `json:"id,omitempty"`
+	DisplayName      string   `json:"displayName,omitempty"`
+	L4Protocol       string   `json:"l4Protocol,omitempty"`
+	DestinationPorts []string `json:"destinationPorts,omitempty"`
+	SourcePorts      []string `json:"sourcePorts,omitempty"`
+}
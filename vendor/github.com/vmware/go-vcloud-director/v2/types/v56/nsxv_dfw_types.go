@@ -0,0 +1,96 @@
+/*
+ * Copyright 2021 VMware, Inc.  All rights reserved.  Licensed under the Apache v2 License.
+ */
+
+package types
+
+import "encoding/xml"
+
+// DfwSection represents a Distributed Firewall section as exposed by the NSX-V Distributed
+// Firewall API proxied through vCD.
+// Reference: vCloud Director API for NSX Programming Guide
+// https://code.vmware.com/docs/6900/vcloud-director-api-for-nsx-programming-guide
+//
+// GenerationNumber must be echoed back as the `If-Match` header on update
+// so that concurrent edits produce the documented "older version" error rather than silently
+// overwriting each other, in the same style as EdgeIpSet.Revision.
+type DfwSection struct {
+	XMLName xml.Name `xml:"section"`
+	ID      string   `xml:"id,attr,omitempty"`
+	Name    string   `xml:"name,attr"`
+	// SectionType is one of LAYER2, LAYER3, L3REDIRECT
+	SectionType string `xml:"type,attr,omitempty"`
+	Stateless   bool   `xml:"stateless,attr"`
+	TcpStrict   bool   `xml:"tcpStrict,attr"`
+	// GenerationNumber is returned by the API as the `generationNumber` attribute and must be
+	// sent back unchanged (via If-Match) when updating the section
+	GenerationNumber string `xml:"generationNumber,attr,omitempty"`
+	Timestamp        int64  `xml:"timestamp,attr,omitempty"`
+
+	Rules []DfwRule `xml:"rule,omitempty"`
+}
+
+// DfwRule represents a single rule inside a DfwSection
+type DfwRule struct {
+	ID       string `xml:"id,omitempty"`
+	Name     string `xml:"name,omitempty"`
+	Disabled bool   `xml:"disabled,omitempty"`
+	Logged   bool   `xml:"logged,omitempty"`
+	Action   string `xml:"action,omitempty"`
+	// Direction is one of in, out, inout
+	Direction string `xml:"direction,omitempty"`
+	// PacketType is one of ipv4, ipv6, any
+	PacketType string `xml:"packetType,omitempty"`
+
+	AppliedToList *DfwAppliedToList   `xml:"appliedToList,omitempty"`
+	Sources       *DfwSourceList      `xml:"sources,omitempty"`
+	Destinations  *DfwDestinationList `xml:"destinations,omitempty"`
+	Services      *DfwServiceList     `xml:"services,omitempty"`
+
+	SectionId  string `xml:"sectionId,omitempty"`
+	Precedence string `xml:"precedence,omitempty"`
+	Tag        string `xml:"tag,omitempty"`
+	Notes      string `xml:"notes,omitempty"`
+}
+
+// DfwAppliedToList wraps the objects (clusters, vApps, VMs, security groups) a DfwRule applies to
+type DfwAppliedToList struct {
+	AppliedTo []DfwEndpoint `xml:"appliedTo,omitempty"`
+}
+
+// DfwSourceList wraps the <source> children of a DfwRule's <sources> element
+type DfwSourceList struct {
+	Excluded  bool          `xml:"excluded,attr,omitempty"`
+	Endpoints []DfwEndpoint `xml:"source,omitempty"`
+}
+
+// DfwDestinationList wraps the <destination> children of a DfwRule's <destinations> element.
+// It is a distinct type from DfwSourceList, rather than a shared one, because the NSX-V DFW
+// schema uses a different child element name under <sources> and <destinations>
+type DfwDestinationList struct {
+	Excluded  bool          `xml:"excluded,attr,omitempty"`
+	Endpoints []DfwEndpoint `xml:"destination,omitempty"`
+}
+
+// DfwEndpoint identifies a single grouping object (security group, IP set, VM, etc.) referenced
+// from a DfwRule
+type DfwEndpoint struct {
+	Name  string `xml:"name,omitempty"`
+	Value string `xml:"value"`
+	Type  string `xml:"type,omitempty"`
+}
+
+// DfwServiceList wraps a list of services a DfwRule matches against
+type DfwServiceList struct {
+	Services []DfwEndpoint `xml:"service,omitempty"`
+}
+
+// DfwSectionAnchor carries the section-position query parameters used by the NSX API to
+// atomically move a whole DfwSection relative to another one.
+type DfwSectionAnchor struct {
+	// Operation is one of insert_before, insert_after, insert_top, insert_bottom
+	Operation string
+	// AnchorId is the ID of the section that Operation is relative to. It is ignored for
+	// insert_top and insert_bottom
+	AnchorId string
+}
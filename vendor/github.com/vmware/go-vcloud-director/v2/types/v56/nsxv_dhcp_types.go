@@ -0,0 +1,97 @@
+/*
+ * Copyright 2021 VMware, Inc.  All rights reserved.  Licensed under the Apache v2 License.
+ */
+
+package types
+
+import "encoding/xml"
+
+// EdgeDhcpRelay configures the DHCP relay service of an NSX-V edge gateway, forwarding DHCP
+// requests to external servers. EdgeIpSet.ID can be used inside
+// RelayServer.GroupingObjectId to relay to an IP set.
+// Reference: vCloud Director API for NSX Programming Guide
+// https://code.vmware.com/docs/6900/vcloud-director-api-for-nsx-programming-guide
+type EdgeDhcpRelay struct {
+	XMLName     xml.Name             `xml:"relay"`
+	RelayServer *EdgeDhcpRelayServer `xml:"relayServer,omitempty"`
+	RelayAgents []EdgeDhcpRelayAgent `xml:"relayAgents>relayAgent,omitempty"`
+}
+
+// EdgeDhcpRelayServer lists the upstream DHCP servers a EdgeDhcpRelay forwards requests to
+type EdgeDhcpRelayServer struct {
+	IpAddress        []string `xml:"ipAddress,omitempty"`
+	FqdnAddress      []string `xml:"fqdnAddress,omitempty"`
+	GroupingObjectId []string `xml:"groupingObjectId,omitempty"`
+	DomainName       []string `xml:"domainName,omitempty"`
+}
+
+// EdgeDhcpRelayAgent binds a EdgeDhcpRelay to a particular edge gateway interface
+type EdgeDhcpRelayAgent struct {
+	Vnic      int    `xml:"vnic"`
+	GiAddress string `xml:"giAddress,omitempty"`
+}
+
+// EdgeDhcp configures the DHCP server service of an NSX-V edge gateway
+type EdgeDhcp struct {
+	XMLName        xml.Name                `xml:"dhcp"`
+	Enabled        bool                    `xml:"enabled"`
+	Logging        *EdgeVpnLogging         `xml:"logging,omitempty"`
+	IpPools        []EdgeDhcpPool          `xml:"ipPools>ipPool,omitempty"`
+	StaticBindings []EdgeDhcpStaticBinding `xml:"staticBindings>staticBinding,omitempty"`
+}
+
+// EdgeDhcpPool represents a single DHCP address pool
+type EdgeDhcpPool struct {
+	ID                  string           `xml:"poolId,omitempty"`
+	AutoConfigureDNS    bool             `xml:"autoConfigureDNS"`
+	DefaultGateway      string           `xml:"defaultGateway,omitempty"`
+	DomainName          string           `xml:"domainName,omitempty"`
+	LeaseTime           string           `xml:"leaseTime,omitempty"`
+	PrimaryNameServer   string           `xml:"primaryNameServer,omitempty"`
+	SecondaryNameServer string           `xml:"secondaryNameServer,omitempty"`
+	IpRange             string           `xml:"ipRange"`
+	SubnetMask          string           `xml:"subnetMask,omitempty"`
+	AllowHugeRange      bool             `xml:"allowHugeRange,omitempty"`
+	DhcpOptions         *EdgeDhcpOptions `xml:"dhcpOptions,omitempty"`
+}
+
+// EdgeDhcpStaticBinding represents a single static MAC/IP binding of the EdgeDhcp service
+type EdgeDhcpStaticBinding struct {
+	ID                  string           `xml:"bindingId,omitempty"`
+	MacAddress          string           `xml:"macAddress"`
+	Hostname            string           `xml:"hostname,omitempty"`
+	IpAddress           string           `xml:"ipAddress"`
+	VmId                string           `xml:"vmId,omitempty"`
+	VnicId              int              `xml:"vnicId,omitempty"`
+	AutoConfigureDNS    bool             `xml:"autoConfigureDNS"`
+	DefaultGateway      string           `xml:"defaultGateway,omitempty"`
+	DomainName          string           `xml:"domainName,omitempty"`
+	LeaseTime           string           `xml:"leaseTime,omitempty"`
+	PrimaryNameServer   string           `xml:"primaryNameServer,omitempty"`
+	SecondaryNameServer string           `xml:"secondaryNameServer,omitempty"`
+	SubnetMask          string           `xml:"subnetMask,omitempty"`
+	DhcpOptions         *EdgeDhcpOptions `xml:"dhcpOptions,omitempty"`
+}
+
+// EdgeDhcpOptions carries the less common DHCP options that can be attached to an EdgeDhcpPool or
+// an EdgeDhcpStaticBinding
+type EdgeDhcpOptions struct {
+	Option121 *EdgeDhcpOption121 `xml:"option121,omitempty"`
+	Option26  int                `xml:"option26,omitempty"`
+	Option60  string             `xml:"option60,omitempty"`
+	Option66  string             `xml:"option66,omitempty"`
+	Option67  string             `xml:"option67,omitempty"`
+	Option150 []string           `xml:"option150>tftpServer,omitempty"`
+	Option160 string             `xml:"option160,omitempty"`
+}
+
+// EdgeDhcpOption121 carries the classless static routes pushed to DHCP clients
+type EdgeDhcpOption121 struct {
+	StaticRoutes []EdgeDhcpStaticRoute `xml:"staticRoutes,omitempty"`
+}
+
+// EdgeDhcpStaticRoute represents a single classless static route in EdgeDhcpOption121
+type EdgeDhcpStaticRoute struct {
+	DestinationSubnet string `xml:"destinationSubnet,omitempty"`
+	Router            string `xml:"router,omitempty"`
+}
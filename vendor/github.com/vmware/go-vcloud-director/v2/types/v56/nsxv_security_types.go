@@ -0,0 +1,94 @@
+/*
+ * Copyright 2021 VMware, Inc.  All rights reserved.  Licensed under the Apache v2 License.
+ */
+
+package types
+
+import "encoding/xml"
+
+// NsxSecurityGroup represents an NSX-V security group ("grouping object") that can be referenced
+// by ID from EdgeFirewallEndpoint.GroupingObjectIds so that firewall/NAT/IP-set configuration can
+// compose real named objects instead of raw IDs.
+// Reference: vCloud Director API for NSX Programming Guide
+// https://code.vmware.com/docs/6900/vcloud-director-api-for-nsx-programming-guide
+type NsxSecurityGroup struct {
+	XMLName     xml.Name `xml:"securitygroup"`
+	ID          string   `xml:"objectId,omitempty"`
+	Name        string   `xml:"name"`
+	Description string   `xml:"description,omitempty"`
+	Scope       string   `xml:"scope,omitempty"`
+
+	IncludeMembers []NsxSecurityGroupMember `xml:"member,omitempty"`
+	ExcludeMembers []NsxSecurityGroupMember `xml:"excludeMember,omitempty"`
+
+	DynamicMemberDefinition *DynamicMemberDefinition `xml:"dynamicMemberDefinition,omitempty"`
+}
+
+// NsxSecurityGroupMember identifies a single static member (VM, network, security tag, etc.)
+// included in or excluded from an NsxSecurityGroup
+type NsxSecurityGroupMember struct {
+	ObjectId string `xml:"objectId"`
+	Name     string `xml:"name,omitempty"`
+	Type     string `xml:"type,omitempty"`
+}
+
+// DynamicMemberDefinition defines the dynamic membership criteria of an NsxSecurityGroup
+type DynamicMemberDefinition struct {
+	DynamicSet []DynamicSet `xml:"dynamicSet,omitempty"`
+}
+
+// DynamicSet groups DynamicCriteria together using Operator (OR/AND)
+type DynamicSet struct {
+	// Operator is one of OR, AND and combines the criteria within DynamicCriteria
+	Operator        string            `xml:"operator,omitempty"`
+	DynamicCriteria []DynamicCriteria `xml:"dynamicCriteria,omitempty"`
+}
+
+// DynamicCriteria represents a single dynamic membership condition
+type DynamicCriteria struct {
+	// Key is one of VM.NAME, VM.GUEST_OS_FULL_NAME, VM.SECURITY_TAG, VM.HOSTNAME
+	Key string `xml:"key,omitempty"`
+	// Operator is one of contains, starts_with, ends_with, equals_to, matches, not_equals_to
+	Operator string `xml:"criteria,omitempty"`
+	Value    string `xml:"value,omitempty"`
+	IsValid  bool   `xml:"isValid,omitempty"`
+}
+
+// NsxSecurityTag represents an NSX-V security tag that can be applied to VMs and referenced from
+// DynamicCriteria with Key=VM.SECURITY_TAG
+type NsxSecurityTag struct {
+	XMLName     xml.Name                 `xml:"securityTag"`
+	ID          string                   `xml:"objectId,omitempty"`
+	Name        string                   `xml:"name"`
+	Description string                   `xml:"description,omitempty"`
+	VMs         []NsxSecurityGroupMember `xml:"vm,omitempty"`
+}
+
+// NsxService represents a single L4 service definition (protocol/port) usable in firewall rules
+type NsxService struct {
+	XMLName     xml.Name `xml:"application"`
+	ID          string   `xml:"objectId,omitempty"`
+	Name        string   `xml:"name"`
+	Description string   `xml:"description,omitempty"`
+	Protocol    string   `xml:"element>applicationProtocol,omitempty"`
+	Ports       string   `xml:"element>value,omitempty"`
+}
+
+// NsxServiceGroup groups multiple NsxService/NsxServiceGroup members into a single referenceable
+// object, mirroring the "service composer" style grouping vCD's UI exposes
+type NsxServiceGroup struct {
+	XMLName     xml.Name                 `xml:"applicationGroup"`
+	ID          string                   `xml:"objectId,omitempty"`
+	Name        string                   `xml:"name"`
+	Description string                   `xml:"description,omitempty"`
+	Members     []NsxSecurityGroupMember `xml:"member,omitempty"`
+}
+
+// MembersOfSecurityGroup represents a single resolved member as returned when enumerating the
+// membership of an NsxSecurityGroup
+type MembersOfSecurityGroup struct {
+	Name       string `xml:"name,omitempty"`
+	MemberType string `xml:"type,omitempty"`
+	Moid       string `xml:"objectId,omitempty"`
+	IsValid    bool   `xml:"isValid,omitempty"`
+}
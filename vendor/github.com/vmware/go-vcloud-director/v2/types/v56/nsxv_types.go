@@ -4,7 +4,13 @@
 
 package types
 
-import "encoding/xml"
+import (
+	"encoding/xml"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+)
 
 // FirewallConfigWithXml allows to enable/disable firewall on a specific edge gateway
 // Reference: vCloud Director API for NSX Programming Guide
@@ -139,10 +145,27 @@ type LbAppProfile struct {
 	Persistence                   *LbAppProfilePersistence  `xml:"persistence,omitempty"`
 	InsertXForwardedForHttpHeader bool                      `xml:"insertXForwardedFor"`
 	ServerSslEnabled              bool                      `xml:"serverSslEnabled"`
+	ClientSsl                     *LbAppProfileSsl          `xml:"clientSsl,omitempty"`
+	ServerSsl                     *LbAppProfileSsl          `xml:"serverSsl,omitempty"`
 }
 
 type LbAppProfiles []LbAppProfile
 
+// LbAppProfileSsl defines the TLS termination settings nested in LbAppProfile.ClientSsl and
+// LbAppProfile.ServerSsl, allowing a virtual server's certificate, CA chain and cipher policy to
+// be expressed without hand-crafted XML. Certificates are referenced by CertificateLibraryItem ID.
+type LbAppProfileSsl struct {
+	ServiceCertificateId string   `xml:"serviceCertificateId,omitempty"`
+	CaCertificateIds     []string `xml:"caCertificateId,omitempty"`
+	CrlIds               []string `xml:"crlId,omitempty"`
+	CipherList           string   `xml:"cipherList,omitempty"`
+	Ciphersuites         string   `xml:"ciphersuites,omitempty"`
+	// Protocols is one or more of TLS_V1, TLS_V1_1, TLS_V1_2
+	Protocols []string `xml:"protocol,omitempty"`
+	// ClientAuth is one of required, ignore. Only meaningful on ClientSsl
+	ClientAuth string `xml:"clientAuth,omitempty"`
+}
+
 // LbAppProfilePersistence defines persistence profile settings in LbAppProfile
 type LbAppProfilePersistence struct {
 	XMLName    xml.Name `xml:"persistence"`
@@ -194,21 +217,26 @@ type LbVirtualServer struct {
 // NSX-V proxied edge gateway endpoint
 // https://code.vmware.com/docs/6900/vcloud-director-api-for-nsx-programming-guide
 type EdgeNatRule struct {
-	XMLName           xml.Name `xml:"natRule"`
-	ID                string   `xml:"ruleId,omitempty"`
-	RuleType          string   `xml:"ruleType,omitempty"`
-	RuleTag           string   `xml:"ruleTag,omitempty"`
-	Action            string   `xml:"action"`
-	Vnic              *int     `xml:"vnic,omitempty"`
-	OriginalAddress   string   `xml:"originalAddress"`
-	TranslatedAddress string   `xml:"translatedAddress"`
-	LoggingEnabled    bool     `xml:"loggingEnabled"`
-	Enabled           bool     `xml:"enabled"`
-	Description       string   `xml:"description,omitempty"`
-	Protocol          string   `xml:"protocol,omitempty"`
-	OriginalPort      string   `xml:"originalPort,omitempty"`
-	TranslatedPort    string   `xml:"translatedPort,omitempty"`
-	IcmpType          string   `xml:"icmpType,omitempty"`
+	XMLName xml.Name `xml:"natRule"`
+	ID      string   `xml:"ruleId,omitempty"`
+	// RuleType is one of user, internal_high or nat64 - the latter identifies a NSX 6.4 NAT64
+	// rule translating an IPv6 OriginalAddress to an IPv4 TranslatedAddress (or vice versa)
+	RuleType          string `xml:"ruleType,omitempty"`
+	RuleTag           string `xml:"ruleTag,omitempty"`
+	Action            string `xml:"action"`
+	Vnic              *int   `xml:"vnic,omitempty"`
+	OriginalAddress   string `xml:"originalAddress"`
+	TranslatedAddress string `xml:"translatedAddress"`
+	LoggingEnabled    bool   `xml:"loggingEnabled"`
+	Enabled           bool   `xml:"enabled"`
+	Description       string `xml:"description,omitempty"`
+	Protocol          string `xml:"protocol,omitempty"`
+	OriginalPort      string `xml:"originalPort,omitempty"`
+	TranslatedPort    string `xml:"translatedPort,omitempty"`
+	IcmpType          string `xml:"icmpType,omitempty"`
+	// IPVersion is one of IPV4, IPV6 and disambiguates OriginalAddress/TranslatedAddress when
+	// RuleType is nat64. Left empty, the rule is assumed to be IPV4 for backwards compatibility
+	IPVersion string `xml:"ipVersion,omitempty"`
 }
 
 // EdgeFirewall holds data for creating firewall rule using proxied NSX-V API
@@ -227,6 +255,9 @@ type EdgeFirewallRule struct {
 	Action          string                  `xml:"action,omitempty"`
 	Enabled         bool                    `xml:"enabled"`
 	LoggingEnabled  bool                    `xml:"loggingEnabled"`
+	// IPVersion is one of IPV4, IPV6, IPV4_IPV6. Left empty, the rule matches IPV4 only for
+	// backwards compatibility
+	IPVersion string `xml:"ipVersion,omitempty"`
 }
 
 // EdgeFirewallEndpoint can contains slices of objects for source or destination in EdgeFirewall
@@ -269,7 +300,10 @@ type EdgeIpSet struct {
 	// IPAddresses is a mandatory field with comma separated values. The API is known to re-order
 	// data after submiting and may shuffle components even if re-submitted as it was return from
 	// API itself
-	// (eg: "192.168.200.1,192.168.200.1/24,192.168.200.1-192.168.200.24")
+	// (eg: "192.168.200.1,192.168.200.1/24,192.168.200.1-192.168.200.24"). IPv6 literals,
+	// prefixes and ranges are valid here too and mixed IPv4/IPv6 families are preserved across
+	// the API's re-ordering - use ParseAddresses/SetAddresses instead of parsing this field
+	// directly so that idempotent Terraform diffs don't flap when the API shuffles entries.
 	IPAddresses string `xml:"value"`
 	// InheritanceAllowed defines visibility at underlying scopes
 	InheritanceAllowed *bool `xml:"inheritanceAllowed"`
@@ -284,3 +318,103 @@ type EdgeIpSet struct {
 
 // EdgeIpSets is a slice of pointers to EdgeIpSet
 type EdgeIpSets []*EdgeIpSet
+
+// IPAddressRange is a pair of addresses of the same family ("from-to", inclusive) as accepted by
+// EdgeIpSet.IPAddresses. The standard library's net/netip does not ship a range type, so
+// EdgeIpSetEntry carries this alongside netip.Prefix for single addresses and CIDRs.
+type IPAddressRange struct {
+	From netip.Addr
+	To   netip.Addr
+}
+
+// EdgeIpSetEntry is a single entry of EdgeIpSet.IPAddresses, either a Prefix (a bare address is
+// represented as a /32 or /128 prefix) or a Range, but never both. Parsed entries are kept in one
+// slice, sorted by their first address, so that a mix of addresses, CIDRs and ranges round-trips
+// through ParseAddresses/SetAddresses without prefixes being grouped ahead of ranges.
+type EdgeIpSetEntry struct {
+	Prefix *netip.Prefix
+	Range  *IPAddressRange
+}
+
+// ParseAddresses splits IPAddresses into its comma separated entries and parses each one into an
+// EdgeIpSetEntry. Entries are sorted by first address (IPv4 before IPv6) and then by text form, so
+// the same set of entries yields the same slice regardless of how the API last shuffled them,
+// which is what callers should diff against to keep Terraform plans stable.
+func (set EdgeIpSet) ParseAddresses() ([]EdgeIpSetEntry, error) {
+	var entries []EdgeIpSetEntry
+
+	for _, rawEntry := range strings.Split(set.IPAddresses, ",") {
+		rawEntry = strings.TrimSpace(rawEntry)
+		if rawEntry == "" {
+			continue
+		}
+
+		switch {
+		case strings.Contains(rawEntry, "/"):
+			prefix, err := netip.ParsePrefix(rawEntry)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing '%s' as a prefix: %s", rawEntry, err)
+			}
+			entries = append(entries, EdgeIpSetEntry{Prefix: &prefix})
+		case strings.Contains(rawEntry, "-"):
+			from, to, found := strings.Cut(rawEntry, "-")
+			if !found {
+				return nil, fmt.Errorf("error parsing '%s' as a range", rawEntry)
+			}
+			fromAddr, err := netip.ParseAddr(strings.TrimSpace(from))
+			if err != nil {
+				return nil, fmt.Errorf("error parsing '%s' as a range start address: %s", rawEntry, err)
+			}
+			toAddr, err := netip.ParseAddr(strings.TrimSpace(to))
+			if err != nil {
+				return nil, fmt.Errorf("error parsing '%s' as a range end address: %s", rawEntry, err)
+			}
+			entries = append(entries, EdgeIpSetEntry{Range: &IPAddressRange{From: fromAddr, To: toAddr}})
+		default:
+			addr, err := netip.ParseAddr(rawEntry)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing '%s' as an address: %s", rawEntry, err)
+			}
+			prefix := netip.PrefixFrom(addr, addr.BitLen())
+			entries = append(entries, EdgeIpSetEntry{Prefix: &prefix})
+		}
+	}
+
+	key := func(entry EdgeIpSetEntry) (netip.Addr, string) {
+		if entry.Prefix != nil {
+			return entry.Prefix.Addr(), entry.Prefix.String()
+		}
+		return entry.Range.From, entry.Range.From.String() + "-" + entry.Range.To.String()
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		addrI, textI := key(entries[i])
+		addrJ, textJ := key(entries[j])
+		if cmp := addrI.Compare(addrJ); cmp != 0 {
+			return cmp < 0
+		}
+		return textI < textJ
+	})
+
+	return entries, nil
+}
+
+// SetAddresses canonicalizes entries back into the comma separated IPAddresses string, keeping
+// mixed IPv4/IPv6 families and mixed prefixes/ranges in the order given rather than relying on
+// the API's own (undocumented) re-ordering, so that repeated applies stop flapping.
+func (set *EdgeIpSet) SetAddresses(entries []EdgeIpSetEntry) {
+	rawEntries := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		switch {
+		case entry.Prefix != nil:
+			if entry.Prefix.Bits() == entry.Prefix.Addr().BitLen() {
+				rawEntries = append(rawEntries, entry.Prefix.Addr().String())
+				continue
+			}
+			rawEntries = append(rawEntries, entry.Prefix.String())
+		case entry.Range != nil:
+			rawEntries = append(rawEntries, entry.Range.From.String()+"-"+entry.Range.To.String())
+		}
+	}
+
+	set.IPAddresses = strings.Join(rawEntries, ",")
+}
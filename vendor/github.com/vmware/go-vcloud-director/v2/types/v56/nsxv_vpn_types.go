@@ -0,0 +1,143 @@
+/*
+ * Copyright 2021 VMware, Inc.  All rights reserved.  Licensed under the Apache v2 License.
+ */
+
+package types
+
+import "encoding/xml"
+
+// EdgeIpsecVpn configures the IPsec VPN service of an NSX-V edge gateway.
+// Reference: vCloud Director API for NSX Programming Guide
+// https://code.vmware.com/docs/6900/vcloud-director-api-for-nsx-programming-guide
+//
+// Version is modeled the same way as FirewallConfigWithXml.Version so that a PUT does not wipe
+// sibling sections of the edge gateway services configuration.
+type EdgeIpsecVpn struct {
+	XMLName xml.Name            `xml:"ipsec"`
+	Enabled bool                `xml:"enabled"`
+	Logging *EdgeVpnLogging     `xml:"logging,omitempty"`
+	Global  *EdgeIpsecVpnGlobal `xml:"global,omitempty"`
+	Sites   []EdgeIpsecVpnSite  `xml:"sites>site,omitempty"`
+	Version string              `xml:"version,omitempty"`
+}
+
+// EdgeVpnLogging configures logging for any of the edge gateway VPN services
+type EdgeVpnLogging struct {
+	Enable   bool   `xml:"enable"`
+	LogLevel string `xml:"logLevel,omitempty"`
+}
+
+// EdgeIpsecVpnGlobal carries the global PSK and the certificates shared by all EdgeIpsecVpnSite
+// entries; the certificates are used by sites with x.509 authentication
+type EdgeIpsecVpnGlobal struct {
+	PSK                string   `xml:"psk,omitempty"`
+	ServiceCertificate string   `xml:"serviceCertificate,omitempty"`
+	CaCertificates     []string `xml:"caCertificates>caCertificate,omitempty"`
+	CrlCertificates    []string `xml:"crlCertificates>crlCertificate,omitempty"`
+}
+
+// EdgeIpsecVpnSite represents a single IPsec VPN tunnel endpoint
+type EdgeIpsecVpnSite struct {
+	ID          string `xml:"id,omitempty"`
+	Enabled     bool   `xml:"enabled"`
+	Name        string `xml:"name,omitempty"`
+	Description string `xml:"description,omitempty"`
+
+	LocalId string `xml:"localId,omitempty"`
+	LocalIp string `xml:"localIp,omitempty"`
+	PeerId  string `xml:"peerId,omitempty"`
+	PeerIp  string `xml:"peerIp,omitempty"`
+
+	// EncryptionAlgorithm is one of AES, AES256, AES-GCM, 3DES
+	EncryptionAlgorithm string `xml:"encryptionAlgorithm,omitempty"`
+	// AuthenticationMode is one of psk, x.509
+	AuthenticationMode string `xml:"authenticationMode,omitempty"`
+	Psk                string `xml:"psk,omitempty"`
+	// DhGroup is one of DH2, DH5, DH14, DH15, DH16
+	DhGroup   string `xml:"dhGroup,omitempty"`
+	Mtu       int    `xml:"mtu,omitempty"`
+	Extension string `xml:"extension,omitempty"`
+
+	LocalSubnets []string `xml:"localSubnets>subnet,omitempty"`
+	PeerSubnets  []string `xml:"peerSubnets>subnet,omitempty"`
+
+	EnablePfs bool `xml:"enablePfs"`
+	// IkeOption is one of ikev1, ikev2, ikev1_ikev2
+	IkeOption string `xml:"ikeOption,omitempty"`
+}
+
+// EdgeL2Vpn configures the L2 VPN service of an NSX-V edge gateway, stretching a Layer 2 network
+// across sites
+type EdgeL2Vpn struct {
+	XMLName xml.Name         `xml:"l2Vpn"`
+	Enabled bool             `xml:"enabled"`
+	Server  *EdgeL2VpnServer `xml:"server,omitempty"`
+	Sites   []L2VpnPeerSite  `xml:"peerSites>site,omitempty"`
+	Version string           `xml:"version,omitempty"`
+}
+
+// EdgeL2VpnServer configures the server side of an EdgeL2Vpn
+type EdgeL2VpnServer struct {
+	ListenerIp          string `xml:"listenerIp,omitempty"`
+	ListenerPort        string `xml:"listenerPort,omitempty"`
+	EncryptionAlgorithm string `xml:"encryptionAlgorithm,omitempty"`
+	ServerCertificate   string `xml:"serverCertificate,omitempty"`
+}
+
+// L2VpnPeerSite represents a single client peer site of an EdgeL2Vpn server
+type L2VpnPeerSite struct {
+	Name        string `xml:"name,omitempty"`
+	Description string `xml:"description,omitempty"`
+	EndpointIp  string `xml:"endpointIp,omitempty"`
+	PeerCode    string `xml:"peerCode,omitempty"`
+	UserId      string `xml:"userId,omitempty"`
+	Password    string `xml:"password,omitempty"`
+
+	StretchedInterfaces []L2VpnStretchedInterface `xml:"stretchedInterfaces>stretchedInterface,omitempty"`
+
+	EgressOptimizationGatewayAddress []string `xml:"egressOptimizationGatewayAddress,omitempty"`
+}
+
+// L2VpnStretchedInterface identifies a single network stretched over an L2VpnPeerSite tunnel
+type L2VpnStretchedInterface struct {
+	TunnelId  string `xml:"tunnelId,omitempty"`
+	VnicIndex int    `xml:"vnicIndex,omitempty"`
+}
+
+// EdgeSslVpn configures the client SSL VPN service of an NSX-V edge gateway
+type EdgeSslVpn struct {
+	XMLName xml.Name `xml:"sslvpnConfig"`
+	Enabled bool     `xml:"enabled"`
+
+	Listener             *EdgeSslVpnListener   `xml:"listener,omitempty"`
+	ClientAuthentication *EdgeSslVpnClientAuth `xml:"authenticationConfiguration>clientAuthentication,omitempty"`
+	IpAddressPools       []EdgeSslVpnIpPool    `xml:"ipAddressPools>ipAddressPool,omitempty"`
+
+	Version string `xml:"version,omitempty"`
+}
+
+// EdgeSslVpnListener configures the address, port and TLS settings the SSL VPN service accepts
+// client connections on
+type EdgeSslVpnListener struct {
+	IpAddress          string `xml:"ipAddress,omitempty"`
+	Port               int    `xml:"port,omitempty"`
+	CipherList         string `xml:"cipherList,omitempty"`
+	ServiceCertificate string `xml:"certificateId,omitempty"`
+}
+
+// EdgeSslVpnClientAuth configures how SSL VPN clients authenticate
+type EdgeSslVpnClientAuth struct {
+	// Method is one of password, certificate, rsa_securid
+	Method string `xml:"method,omitempty"`
+	// ClientAuth is one of required, ignore, mirroring LbAppProfileSsl.ClientAuth
+	ClientAuth string `xml:"clientAuth,omitempty"`
+}
+
+// EdgeSslVpnIpPool represents a single virtual IP pool handed out to connected SSL VPN clients
+type EdgeSslVpnIpPool struct {
+	ID          string `xml:"objectId,omitempty"`
+	IpRange     string `xml:"ipRange"`
+	NetMask     string `xml:"netmask,omitempty"`
+	Gateway     string `xml:"gateway,omitempty"`
+	Description string `xml:"description,omitempty"`
+}